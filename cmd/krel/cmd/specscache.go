@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/obs/specs/store"
+)
+
+var specsCacheOlderThan time.Duration
+
+// specsCacheCmd inspects and manages the on-disk store that
+// specs.BuildArtifactsArchive uses to cache previously downloaded and
+// verified package sources.
+var specsCacheCmd = &cobra.Command{
+	Use:   "specs-cache",
+	Short: "Inspect and manage the cache of downloaded OBS package spec sources",
+}
+
+var specsCacheListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the sources currently cached on disk",
+	RunE: func(*cobra.Command, []string) error {
+		s, err := store.New("")
+		if err != nil {
+			return fmt.Errorf("opening cache: %w", err)
+		}
+
+		entries, err := s.List()
+		if err != nil {
+			return fmt.Errorf("listing cache: %w", err)
+		}
+
+		for _, entry := range entries {
+			fmt.Printf("%s\t%s\t%s\t%s\n", entry.Version, entry.Key, entry.Source, entry.CreatedAt.Format(time.RFC3339))
+		}
+
+		return nil
+	},
+}
+
+var specsCachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove cached sources older than --older-than",
+	RunE: func(*cobra.Command, []string) error {
+		s, err := store.New("")
+		if err != nil {
+			return fmt.Errorf("opening cache: %w", err)
+		}
+
+		removed, err := s.Prune(specsCacheOlderThan)
+		if err != nil {
+			return fmt.Errorf("pruning cache: %w", err)
+		}
+
+		for _, key := range removed {
+			fmt.Println("removed", key)
+		}
+
+		return nil
+	},
+}
+
+var specsCacheRemoveCmd = &cobra.Command{
+	Use:   "remove <version>",
+	Short: "Remove the cached source for a single package version",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		s, err := store.New("")
+		if err != nil {
+			return fmt.Errorf("opening cache: %w", err)
+		}
+
+		return s.RemoveVersion(args[0])
+	},
+}
+
+func init() {
+	specsCachePruneCmd.Flags().DurationVar(
+		&specsCacheOlderThan, "older-than", 30*24*time.Hour, "remove cached sources older than this duration",
+	)
+
+	specsCacheCmd.AddCommand(specsCacheListCmd, specsCachePruneCmd, specsCacheRemoveCmd)
+	rootCmd.AddCommand(specsCacheCmd)
+}
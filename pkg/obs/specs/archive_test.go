@@ -17,16 +17,24 @@ limitations under the License.
 package specs_test
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"k8s.io/release/pkg/obs/specs"
+	"k8s.io/release/pkg/obs/specs/sbom"
 	"k8s.io/release/pkg/obs/specs/specsfakes"
 )
 
+var err = errors.New("test error")
+
 func TestBuildArtifactsArchive(t *testing.T) {
 	testcases := []struct {
 		name             string
@@ -56,11 +64,11 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "amd64",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 					{
 						Architecture: "arm64",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -84,7 +92,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -102,7 +110,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -120,7 +128,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -138,7 +146,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -154,7 +162,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -172,7 +180,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -190,7 +198,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -208,7 +216,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -227,7 +235,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -245,7 +253,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "s390x",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -263,7 +271,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "ppc64le",
-						Source:       "https://example.com/artifact.tar",
+						Source:       []string{"https://example.com/artifact.tar"},
 					},
 				},
 			},
@@ -281,7 +289,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "arm64",
-						Source:       "https://example.com/artifact.tar",
+						Source:       []string{"https://example.com/artifact.tar"},
 					},
 				},
 			},
@@ -300,7 +308,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "ppc64le",
-						Source:       "https://example.com/artifact.tar",
+						Source:       []string{"https://example.com/artifact.tar"},
 					},
 				},
 			},
@@ -308,6 +316,156 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				mock.CreateFileReturns(nil, err)
 			},
 		},
+		{
+			name:      "throw error on sha256 digest mismatch",
+			shouldErr: true,
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "",
+						Source:       []string{"gs://"},
+						SHA256:       "deadbeef",
+					},
+				},
+			},
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.SHA256ForFileReturns("0000000000000000000000000000000000000000000000000000000000000000", nil)
+			},
+		},
+		{
+			name:      "throw error on sha512 digest mismatch",
+			shouldErr: true,
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "",
+						Source:       []string{"gs://"},
+						SHA512:       "deadbeef",
+					},
+				},
+			},
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.SHA512ForFileReturns("0000000000000000000000000000000000000000000000000000000000000000", nil)
+			},
+		},
+		{
+			name:             "don't throw an error when declared digest matches",
+			shouldErr:        false,
+			destinationPaths: []string{"cri-o\\cri-o.tar.gz"},
+			archiveFile:      "cri-o_0.0.1.orig.tar.gz",
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "",
+						Source:       []string{"gs://"},
+						SHA256:       "abcd",
+					},
+				},
+			},
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.SHA256ForFileReturns("ABCD", nil)
+			},
+		},
+		{
+			name:      "throw error when signature verification fails",
+			shouldErr: true,
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "",
+						Source:       []string{"gs://"},
+						Signature:    "-----BEGIN PGP SIGNATURE-----\n...\n-----END PGP SIGNATURE-----",
+					},
+				},
+			},
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.VerifySignatureReturns(err)
+			},
+		},
+		{
+			name:      "throw error when public keyring fails to load",
+			shouldErr: true,
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "",
+						Source:       []string{"gs://"},
+						Signature:    "-----BEGIN PGP SIGNATURE-----\n...\n-----END PGP SIGNATURE-----",
+						PublicKey:    "/keys/cri-o.asc",
+					},
+				},
+			},
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.LoadPublicKeyringReturns("", err)
+			},
+		},
+		{
+			name:      "local file source with CopyLocalFile error",
+			shouldErr: true,
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "",
+						Source:       []string{"file:///tmp/cri-o.tar.gz"},
+					},
+				},
+			},
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.CopyLocalFileReturns(err)
+			},
+		},
+		{
+			name:      "oci source with registry auth failure",
+			shouldErr: true,
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "amd64",
+						Source:       []string{"oci://registry.example.com/cri-o:v1.0.0"},
+					},
+				},
+			},
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.FetchOCIArtifactReturns(err)
+			},
+		},
+		{
+			name:      "error on unsupported source scheme",
+			shouldErr: true,
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "",
+						Source:       []string{"ftp://example.com/cri-o.tar.gz"},
+					},
+				},
+			},
+			prepare: func(*specsfakes.FakeImpl) {},
+		},
 		{
 			name:             "don't throw an error when download directory exists",
 			shouldErr:        false,
@@ -320,7 +478,7 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				Variations: []specs.PackageVariation{
 					{
 						Architecture: "amd64",
-						Source:       "gs://",
+						Source:       []string{"gs://"},
 					},
 				},
 			},
@@ -329,6 +487,42 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				mock.IsExistReturns(true)
 			},
 		},
+		{
+			name:      "throw error when checking the cache fails",
+			shouldErr: true,
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "amd64",
+						Source:       []string{"gs://"},
+					},
+				},
+			},
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.StoreLookupReturns("", false, err)
+			},
+		},
+		{
+			name:      "throw error when caching the downloaded source fails",
+			shouldErr: true,
+			pkgDef: &specs.PackageDefinition{
+				Name:           "cri-o",
+				Version:        "0.0.1",
+				SpecOutputPath: t.TempDir(),
+				Variations: []specs.PackageVariation{
+					{
+						Architecture: "amd64",
+						Source:       []string{"gs://"},
+					},
+				},
+			},
+			prepare: func(mock *specsfakes.FakeImpl) {
+				mock.StoreInsertReturns("", err)
+			},
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -347,12 +541,20 @@ func TestBuildArtifactsArchive(t *testing.T) {
 				require.Error(t, err)
 			} else {
 				if mock.GCSCopyToLocalCallCount() > 0 {
-					// check destination paths for all variations
+					// check destination paths for all variations; variations
+					// download concurrently, so compare as sets rather than
+					// asserting on call order.
+					expectedDestinationPaths := make([]string, len(tc.destinationPaths))
 					for i, expectedPath := range tc.destinationPaths {
-						_, actualDestinationPath := mock.GCSCopyToLocalArgsForCall(i)
-						expectedDestinationPath := filepath.Join(tc.pkgDef.SpecOutputPath, expectedPath)
-						require.Equal(t, expectedDestinationPath, actualDestinationPath)
+						expectedDestinationPaths[i] = filepath.Join(tc.pkgDef.SpecOutputPath, expectedPath)
 					}
+
+					actualDestinationPaths := make([]string, mock.GCSCopyToLocalCallCount())
+					for i := range actualDestinationPaths {
+						_, actualDestinationPaths[i] = mock.GCSCopyToLocalArgsForCall(i)
+					}
+
+					require.ElementsMatch(t, expectedDestinationPaths, actualDestinationPaths)
 				}
 
 				// check archive destination
@@ -367,3 +569,310 @@ func TestBuildArtifactsArchive(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildArtifactsArchiveOCIArchSelection(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "cri-o",
+		Version:        "0.0.1",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{Architecture: "arm64", Source: []string{"oci://registry.example.com/cri-o:v1.0.0"}},
+		},
+	}
+
+	mock := &specsfakes.FakeImpl{}
+	sut := specs.New(&specs.Options{})
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.BuildArtifactsArchive(pkgDef))
+	require.Equal(t, 1, mock.FetchOCIArtifactCallCount())
+
+	ref, arch, _ := mock.FetchOCIArtifactArgsForCall(0)
+	require.Equal(t, "registry.example.com/cri-o:v1.0.0", ref)
+	require.Equal(t, "arm64", arch)
+}
+
+func fastRetryOptions() specs.DownloadRetryOptions {
+	return specs.DownloadRetryOptions{
+		MaxAttempts:    2,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		RateLimitQPS:   1000,
+	}
+}
+
+func TestBuildArtifactsArchiveRetriesFlakyMirrorThenFallsThrough(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "something-else",
+		Version:        "0.0.1",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{
+				Architecture: "amd64",
+				Source: []string{
+					"https://flaky.example.com/artifact.tar",
+					"https://healthy.example.com/artifact.tar",
+				},
+			},
+		},
+	}
+
+	mock := &specsfakes.FakeImpl{}
+	mock.GetRequestReturnsOnCall(0, &http.Response{StatusCode: http.StatusServiceUnavailable}, nil)
+	mock.GetRequestReturnsOnCall(1, &http.Response{StatusCode: http.StatusServiceUnavailable}, nil)
+	mock.GetRequestReturnsOnCall(2, &http.Response{StatusCode: http.StatusOK}, nil)
+
+	sut := specs.New(&specs.Options{DownloadRetry: fastRetryOptions()})
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.BuildArtifactsArchive(pkgDef))
+	// two failed attempts against the flaky mirror (MaxAttempts), then one
+	// successful attempt against the healthy mirror.
+	require.Equal(t, 3, mock.GetRequestCallCount())
+
+	// the cache, and therefore the SBOM's provenance record, must
+	// attribute the download to the mirror that actually served it, not
+	// variation.Source's first-preference entry.
+	require.Equal(t, 1, mock.StoreInsertCallCount())
+	_, _, _, source, _, _ := mock.StoreInsertArgsForCall(0)
+	require.Equal(t, "https://healthy.example.com/artifact.tar", source)
+}
+
+func TestBuildArtifactsArchiveFallsThroughOn404WithoutRetrying(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "something-else",
+		Version:        "0.0.1",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{
+				Architecture: "amd64",
+				Source: []string{
+					"https://mirror1.example.com/artifact.tar",
+					"https://mirror2.example.com/artifact.tar",
+				},
+			},
+		},
+	}
+
+	mock := &specsfakes.FakeImpl{}
+	mock.GetRequestReturnsOnCall(0, &http.Response{StatusCode: http.StatusNotFound}, nil)
+	mock.GetRequestReturnsOnCall(1, &http.Response{StatusCode: http.StatusOK}, nil)
+
+	sut := specs.New(&specs.Options{DownloadRetry: fastRetryOptions()})
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.BuildArtifactsArchive(pkgDef))
+	// a 404 moves to the next mirror immediately, with no retries spent on
+	// the first one.
+	require.Equal(t, 2, mock.GetRequestCallCount())
+}
+
+func TestBuildArtifactsArchiveCacheHitSkipsDownload(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "cri-o",
+		Version:        "0.0.1",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{Architecture: "amd64", Source: []string{"gs://"}, SHA256: "abcd"},
+		},
+	}
+
+	mock := &specsfakes.FakeImpl{}
+	mock.StoreLookupReturns("/cache/cri-o.tar.gz", true, nil)
+	mock.SHA256ForFileReturns("abcd", nil)
+
+	sut := specs.New(&specs.Options{})
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.BuildArtifactsArchive(pkgDef))
+
+	require.Equal(t, 0, mock.GCSCopyToLocalCallCount())
+	require.Equal(t, 0, mock.StoreInsertCallCount())
+	require.Equal(t, 1, mock.CopyLocalFileCallCount())
+
+	src, dst := mock.CopyLocalFileArgsForCall(0)
+	require.Equal(t, "/cache/cri-o.tar.gz", src)
+	require.Equal(t, filepath.Join(pkgDef.SpecOutputPath, "cri-o\\x86_64\\cri-o.tar.gz"), dst)
+}
+
+func TestBuildArtifactsArchiveCacheHitReverifiesDigest(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "cri-o",
+		Version:        "0.0.1",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{Architecture: "amd64", Source: []string{"gs://"}, SHA256: "abcd"},
+		},
+	}
+
+	mock := &specsfakes.FakeImpl{}
+	mock.StoreLookupReturns("/cache/cri-o.tar.gz", true, nil)
+	mock.SHA256ForFileReturns("tampered", nil)
+
+	sut := specs.New(&specs.Options{})
+	sut.SetImpl(mock)
+
+	require.Error(t, sut.BuildArtifactsArchive(pkgDef))
+	require.Equal(t, 0, mock.StoreInsertCallCount())
+}
+
+func TestBuildArtifactsArchiveCacheMissInsertsAfterDownload(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "cri-o",
+		Version:        "0.0.1",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{Architecture: "amd64", Source: []string{"gs://"}},
+		},
+	}
+
+	mock := &specsfakes.FakeImpl{}
+	mock.StoreLookupReturns("", false, nil)
+
+	sut := specs.New(&specs.Options{})
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.BuildArtifactsArchive(pkgDef))
+
+	require.Equal(t, 1, mock.GCSCopyToLocalCallCount())
+	require.Equal(t, 1, mock.StoreInsertCallCount())
+
+	_, _, sourceFile, source, _, version := mock.StoreInsertArgsForCall(0)
+	require.Equal(t, filepath.Join(pkgDef.SpecOutputPath, "cri-o\\x86_64\\cri-o.tar.gz"), sourceFile)
+	require.Equal(t, "gs://", source)
+	require.Equal(t, "0.0.1", version)
+}
+
+func TestBuildArtifactsArchiveSBOMOrderMatchesVariationOrder(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "kubernetes-cni",
+		Version:        "1.2.3",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{Architecture: "amd64", Source: []string{"gs://"}},
+			{Architecture: "arm64", Source: []string{"gs://"}},
+			{Architecture: "s390x", Source: []string{"gs://"}},
+		},
+	}
+
+	sbomFile, openErr := os.CreateTemp(t.TempDir(), "sbom-*.json")
+	require.NoError(t, openErr)
+
+	mock := &specsfakes.FakeImpl{}
+	mock.CreateFileReturns(sbomFile, nil)
+	// Variations download concurrently (chunk0-4); stub the slowest
+	// download as the *first* variation so a naive append-on-completion
+	// order would put it last instead of first.
+	mock.GCSCopyToLocalStub = func(src, dst string) error {
+		if strings.Contains(dst, "x86_64") {
+			time.Sleep(20 * time.Millisecond)
+		}
+		return nil
+	}
+
+	sut := specs.New(&specs.Options{EmitSBOM: true, SBOMFormats: []sbom.Format{sbom.FormatSPDX}})
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.BuildArtifactsArchive(pkgDef))
+
+	data, readErr := os.ReadFile(sbomFile.Name())
+	require.NoError(t, readErr)
+
+	var doc struct {
+		Files []struct {
+			Comment string `json:"comment"`
+		} `json:"files"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	require.Len(t, doc.Files, 3)
+	require.Contains(t, doc.Files[0].Comment, "architecture=amd64")
+	require.Contains(t, doc.Files[1].Comment, "architecture=arm64")
+	require.Contains(t, doc.Files[2].Comment, "architecture=s390x")
+}
+
+func TestBuildArtifactsArchiveEmitsSBOMWhenConfigured(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "kubernetes-cni",
+		Version:        "1.2.3",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{Architecture: "amd64", Source: []string{"gs://"}},
+		},
+	}
+
+	sbomFile, openErr := os.CreateTemp(t.TempDir(), "sbom-*.json")
+	require.NoError(t, openErr)
+
+	mock := &specsfakes.FakeImpl{}
+	mock.CreateFileReturns(sbomFile, nil)
+	mock.FileSizeReturns(42, nil)
+	mock.SHA256ForFileReturns("deadbeef", nil)
+
+	sut := specs.New(&specs.Options{EmitSBOM: true, SBOMFormats: []sbom.Format{sbom.FormatSPDX}})
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.BuildArtifactsArchive(pkgDef))
+
+	require.Equal(t, 1, mock.CreateFileCallCount())
+	require.Equal(t,
+		filepath.Join(pkgDef.SpecOutputPath, "kubernetes-cni_1.2.3.orig.spdx.json"),
+		mock.CreateFileArgsForCall(0),
+	)
+
+	data, readErr := os.ReadFile(sbomFile.Name())
+	require.NoError(t, readErr)
+
+	var doc struct {
+		Files []struct {
+			FileName  string `json:"fileName"`
+			Checksums []struct {
+				ChecksumValue string `json:"checksumValue"`
+			} `json:"checksums"`
+			Comment string `json:"comment"`
+		} `json:"files"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	require.Len(t, doc.Files, 1)
+	require.Equal(t, "kubernetes-cni.tar.gz", doc.Files[0].FileName)
+	require.Equal(t, "deadbeef", doc.Files[0].Checksums[0].ChecksumValue)
+	require.Contains(t, doc.Files[0].Comment, "architecture=amd64")
+	require.Contains(t, doc.Files[0].Comment, "size=42")
+}
+
+func TestBuildArtifactsArchiveSkipsSBOMByDefault(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "kubernetes-cni",
+		Version:        "1.2.3",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{Architecture: "amd64", Source: []string{"gs://"}},
+		},
+	}
+
+	mock := &specsfakes.FakeImpl{}
+
+	sut := specs.New(&specs.Options{})
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.BuildArtifactsArchive(pkgDef))
+	require.Equal(t, 0, mock.CreateFileCallCount())
+}
+
+func TestBuildArtifactsArchivePropagatesSBOMWriteErrors(t *testing.T) {
+	pkgDef := &specs.PackageDefinition{
+		Name:           "kubernetes-cni",
+		Version:        "1.2.3",
+		SpecOutputPath: t.TempDir(),
+		Variations: []specs.PackageVariation{
+			{Architecture: "amd64", Source: []string{"gs://"}},
+		},
+	}
+
+	mock := &specsfakes.FakeImpl{}
+	mock.CreateFileReturns(nil, err)
+
+	sut := specs.New(&specs.Options{EmitSBOM: true, SBOMFormats: []sbom.Format{sbom.FormatSPDX}})
+	sut.SetImpl(mock)
+
+	require.Error(t, sut.BuildArtifactsArchive(pkgDef))
+}
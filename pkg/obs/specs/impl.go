@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package specs
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"k8s.io/release/pkg/gcp/gcs"
+	"k8s.io/release/pkg/obs/specs/store"
+	"k8s.io/release/pkg/util"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate -o specsfakes/fake_impl.go . Impl
+
+// Impl abstracts the filesystem, network, and archive operations used by
+// Specs so that they can be faked in tests.
+type Impl interface {
+	GCSCopyToLocal(src, dst string) error
+	GetRequest(url string) (*http.Response, error)
+	CreateFile(path string) (*os.File, error)
+	MkdirAll(path string, perm os.FileMode) error
+	IsExist(path string) bool
+	Extract(archivePath, destDir string) error
+	RemoveFile(path string) error
+	RemoveAll(path string) error
+	Compress(archiveFile, sourceDir string, removeSource bool) error
+	SHA256ForFile(path string) (string, error)
+	SHA512ForFile(path string) (string, error)
+	LoadPublicKeyring(path string) (string, error)
+	VerifySignature(path, signature, keyring string) error
+	CopyLocalFile(src, dst string) error
+	FetchOCIArtifact(ref, arch, destination string) error
+	StoreLookup(cacheDir, key string) (path string, hit bool, err error)
+	StoreInsert(cacheDir, key, sourceFile, source, digest, version string) (path string, err error)
+	FileSize(path string) (int64, error)
+}
+
+type defaultImpl struct{}
+
+func (*defaultImpl) GCSCopyToLocal(src, dst string) error {
+	return gcs.CopyToLocal(src, dst)
+}
+
+func (*defaultImpl) GetRequest(url string) (*http.Response, error) {
+	return http.Get(url)
+}
+
+func (*defaultImpl) CreateFile(path string) (*os.File, error) {
+	return os.Create(path)
+}
+
+func (*defaultImpl) MkdirAll(path string, perm os.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
+func (*defaultImpl) IsExist(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func (*defaultImpl) Extract(archivePath, destDir string) error {
+	return util.ExtractArchive(archivePath, destDir)
+}
+
+func (*defaultImpl) RemoveFile(path string) error {
+	return os.Remove(path)
+}
+
+func (*defaultImpl) RemoveAll(path string) error {
+	return os.RemoveAll(path)
+}
+
+func (*defaultImpl) Compress(archiveFile, sourceDir string, removeSource bool) error {
+	return util.Compress(archiveFile, sourceDir, removeSource)
+}
+
+func (*defaultImpl) SHA256ForFile(path string) (string, error) {
+	return hashFile(path, sha256.New())
+}
+
+func (*defaultImpl) SHA512ForFile(path string) (string, error) {
+	return hashFile(path, sha512.New())
+}
+
+func hashFile(path string, h hash.Hash) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (*defaultImpl) LoadPublicKeyring(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// VerifySignature checks a detached, armored PGP signature over the
+// file at path against keyring. cosign-style sidecar signatures are not
+// supported: a raw/base64 ECDSA cosign signature is not PGP-armored and
+// will fail to parse here.
+func (*defaultImpl) VerifySignature(path, signature, keyring string) error {
+	entityList, err := openpgp.ReadArmoredKeyRing(strings.NewReader(keyring))
+	if err != nil {
+		return fmt.Errorf("reading public keyring: %w", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := openpgp.CheckArmoredDetachedSignature(entityList, f, strings.NewReader(signature), nil); err != nil {
+		return fmt.Errorf("checking detached signature: %w", err)
+	}
+
+	return nil
+}
+
+// CopyLocalFile copies a "file://"-sourced artifact that already lives
+// on local disk into the download staging area.
+func (*defaultImpl) CopyLocalFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+
+	return err
+}
+
+// FetchOCIArtifact pulls ref from an OCI registry using crane,
+// authenticating via the standard docker config, and writes its last
+// layer to destination. When ref resolves to a multi-arch image index,
+// arch selects which platform-specific image to pull; an empty arch
+// leaves the decision to crane's default platform resolution.
+func (*defaultImpl) FetchOCIArtifact(ref, arch, destination string) error {
+	opts := []crane.Option{crane.WithAuthFromKeychain(authn.DefaultKeychain)}
+	if arch != "" {
+		opts = append(opts, crane.WithPlatform(&v1.Platform{OS: "linux", Architecture: arch}))
+	}
+
+	img, err := crane.Pull(ref, opts...)
+	if err != nil {
+		return fmt.Errorf("pulling %s: %w", ref, err)
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return fmt.Errorf("reading layers for %s: %w", ref, err)
+	}
+	if len(layers) == 0 {
+		return fmt.Errorf("image %s has no layers", ref)
+	}
+
+	rc, err := layers[len(layers)-1].Uncompressed()
+	if err != nil {
+		return fmt.Errorf("reading layer for %s: %w", ref, err)
+	}
+	defer rc.Close()
+
+	out, err := os.Create(destination)
+	if err != nil {
+		return fmt.Errorf("creating destination file %s: %w", destination, err)
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, rc); err != nil {
+		return fmt.Errorf("writing layer to %s: %w", destination, err)
+	}
+
+	return nil
+}
+
+// StoreLookup checks the on-disk store rooted at cacheDir (the zero
+// value defers to store.New's default) for a previously downloaded and
+// verified artifact under key.
+func (*defaultImpl) StoreLookup(cacheDir, key string) (string, bool, error) {
+	st, err := store.New(cacheDir)
+	if err != nil {
+		return "", false, err
+	}
+
+	return st.Lookup(key)
+}
+
+// StoreInsert copies sourceFile into the on-disk store rooted at
+// cacheDir under key, publishing it via an atomic rename so that a
+// concurrent StoreLookup never observes a partially written artifact.
+// sourceFile itself is left in place for the caller to keep using.
+func (*defaultImpl) StoreInsert(cacheDir, key, sourceFile, source, digest, version string) (string, error) {
+	st, err := store.New(cacheDir)
+	if err != nil {
+		return "", err
+	}
+
+	tempFile, err := copyToTemp(sourceFile, st.Dir())
+	if err != nil {
+		return "", fmt.Errorf("staging %s for the cache: %w", sourceFile, err)
+	}
+
+	return st.Insert(key, tempFile, store.Metadata{
+		Source:    source,
+		Digest:    digest,
+		Version:   version,
+		CreatedAt: time.Now(),
+	})
+}
+
+// FileSize returns the size in bytes of the file at path.
+func (*defaultImpl) FileSize(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+// copyToTemp copies src into a new temporary file under dir, returning
+// its path. Staging the copy in dir (rather than, say, os.TempDir())
+// ensures the later os.Rename into the store is on the same filesystem
+// and therefore atomic.
+func copyToTemp(src, dir string) (string, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+
+	out, err := os.CreateTemp(dir, "store-insert-*")
+	if err != nil {
+		return "", err
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return "", err
+	}
+
+	return out.Name(), nil
+}
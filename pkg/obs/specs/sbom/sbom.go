@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sbom synthesizes SBOM (software bill of materials) documents
+// describing the files that make up a built OBS package archive. It has
+// no dependency on the filesystem: a Builder is constructed from an
+// already-gathered file manifest and writes documents to an io.Writer,
+// so it is exercised in tests without ever touching disk.
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Format identifies an SBOM document format a Builder can emit.
+type Format string
+
+const (
+	// FormatSPDX emits an SPDX 2.3 JSON document.
+	FormatSPDX Format = "spdx"
+	// FormatCycloneDX emits a CycloneDX 1.5 JSON document.
+	FormatCycloneDX Format = "cyclonedx"
+)
+
+// PackageInfo is the subset of a package's identity an SBOM document
+// needs: its name and the version being packaged.
+type PackageInfo struct {
+	Name    string
+	Version string
+}
+
+// FileManifestEntry describes a single file that was downloaded into a
+// package's archive staging directory.
+type FileManifestEntry struct {
+	// Name is the file's base name, without any directory components.
+	Name string
+	// Size is the file's size in bytes.
+	Size int64
+	// SHA256 is the file's hex-encoded SHA256 digest.
+	SHA256 string
+	// Architecture is the PackageVariation.Architecture the file was
+	// downloaded for.
+	Architecture string
+	// Source is the resolved upstream URL the file was downloaded from.
+	Source string
+}
+
+// Builder synthesizes SBOM documents describing pkg's Files.
+type Builder struct {
+	Package PackageInfo
+	Files   []FileManifestEntry
+}
+
+// NewBuilder returns a Builder that describes files as part of pkg.
+func NewBuilder(pkg PackageInfo, files []FileManifestEntry) *Builder {
+	return &Builder{Package: pkg, Files: files}
+}
+
+// Write emits an SBOM document in format to w.
+func (b *Builder) Write(format Format, w io.Writer) error {
+	switch format {
+	case FormatSPDX:
+		return b.WriteSPDX(w)
+	case FormatCycloneDX:
+		return b.WriteCycloneDX(w)
+	default:
+		return fmt.Errorf("unsupported SBOM format: %s", format)
+	}
+}
+
+// spdxDocument is a minimal SPDX 2.3 JSON document: just enough to
+// describe a flat list of files with their checksums.
+type spdxDocument struct {
+	SPDXVersion       string           `json:"spdxVersion"`
+	DataLicense       string           `json:"dataLicense"`
+	SPDXID            string           `json:"SPDXID"`
+	Name              string           `json:"name"`
+	DocumentNamespace string           `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo `json:"creationInfo"`
+	Files             []spdxFile       `json:"files"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxFile struct {
+	FileName  string         `json:"fileName"`
+	SPDXID    string         `json:"SPDXID"`
+	Checksums []spdxChecksum `json:"checksums"`
+	Comment   string         `json:"comment,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+// WriteSPDX writes an SPDX 2.3 JSON document enumerating b.Files to w.
+func (b *Builder) WriteSPDX(w io.Writer) error {
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              fmt.Sprintf("%s-%s", b.Package.Name, b.Package.Version),
+		DocumentNamespace: fmt.Sprintf("https://k8s.io/release/obs/specs/%s-%s.spdx", b.Package.Name, b.Package.Version),
+		CreationInfo: spdxCreationInfo{
+			Created:  time.Now().UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: k8s-release-obs-specs"},
+		},
+		Files: make([]spdxFile, len(b.Files)),
+	}
+
+	for i, file := range b.Files {
+		doc.Files[i] = spdxFile{
+			FileName: file.Name,
+			SPDXID:   fmt.Sprintf("SPDXRef-File-%d", i),
+			Checksums: []spdxChecksum{
+				{Algorithm: "SHA256", ChecksumValue: file.SHA256},
+			},
+			Comment: fmt.Sprintf("architecture=%s size=%d source=%s", file.Architecture, file.Size, file.Source),
+		}
+	}
+
+	return encodeJSON(w, doc)
+}
+
+// cycloneDXDocument is a minimal CycloneDX 1.5 JSON document: just
+// enough to describe a flat list of file components.
+type cycloneDXDocument struct {
+	BOMFormat   string               `json:"bomFormat"`
+	SpecVersion string               `json:"specVersion"`
+	Version     int                  `json:"version"`
+	Metadata    cycloneDXMetadata    `json:"metadata"`
+	Components  []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string                `json:"timestamp"`
+	Component cycloneDXComponentRef `json:"component"`
+}
+
+type cycloneDXComponentRef struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type cycloneDXComponent struct {
+	Type       string              `json:"type"`
+	Name       string              `json:"name"`
+	Hashes     []cycloneDXHash     `json:"hashes,omitempty"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// WriteCycloneDX writes a CycloneDX 1.5 JSON document enumerating
+// b.Files to w.
+func (b *Builder) WriteCycloneDX(w io.Writer) error {
+	doc := cycloneDXDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Component: cycloneDXComponentRef{
+				Type:    "application",
+				Name:    b.Package.Name,
+				Version: b.Package.Version,
+			},
+		},
+		Components: make([]cycloneDXComponent, len(b.Files)),
+	}
+
+	for i, file := range b.Files {
+		doc.Components[i] = cycloneDXComponent{
+			Type: "file",
+			Name: file.Name,
+			Hashes: []cycloneDXHash{
+				{Alg: "SHA-256", Content: file.SHA256},
+			},
+			Properties: []cycloneDXProperty{
+				{Name: "architecture", Value: file.Architecture},
+				{Name: "size", Value: strconv.FormatInt(file.Size, 10)},
+				{Name: "source", Value: file.Source},
+			},
+		}
+	}
+
+	return encodeJSON(w, doc)
+}
+
+func encodeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/obs/specs/sbom"
+)
+
+func testFiles() []sbom.FileManifestEntry {
+	return []sbom.FileManifestEntry{
+		{
+			Name:         "kubernetes-cni.tar.gz",
+			Size:         1024,
+			SHA256:       "abcd1234",
+			Architecture: "amd64",
+			Source:       "gs://k8s-release/kubernetes-cni-amd64.tar.gz",
+		},
+		{
+			Name:         "kubernetes-cni.tar.gz",
+			Size:         2048,
+			SHA256:       "ef567890",
+			Architecture: "arm64",
+			Source:       "gs://k8s-release/kubernetes-cni-arm64.tar.gz",
+		},
+	}
+}
+
+func TestWriteSPDXReferencesEveryFile(t *testing.T) {
+	builder := sbom.NewBuilder(sbom.PackageInfo{Name: "kubernetes-cni", Version: "1.2.3"}, testFiles())
+
+	var buf bytes.Buffer
+	require.NoError(t, builder.WriteSPDX(&buf))
+
+	var doc struct {
+		Name  string `json:"name"`
+		Files []struct {
+			FileName  string `json:"fileName"`
+			Checksums []struct {
+				Algorithm     string `json:"algorithm"`
+				ChecksumValue string `json:"checksumValue"`
+			} `json:"checksums"`
+			Comment string `json:"comment"`
+		} `json:"files"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	require.Equal(t, "kubernetes-cni-1.2.3", doc.Name)
+	require.Len(t, doc.Files, 2)
+
+	require.Equal(t, "kubernetes-cni.tar.gz", doc.Files[0].FileName)
+	require.Equal(t, "abcd1234", doc.Files[0].Checksums[0].ChecksumValue)
+	require.Contains(t, doc.Files[0].Comment, "architecture=amd64")
+	require.Contains(t, doc.Files[0].Comment, "size=1024")
+	require.Contains(t, doc.Files[0].Comment, "source=gs://k8s-release/kubernetes-cni-amd64.tar.gz")
+
+	require.Contains(t, doc.Files[1].Comment, "architecture=arm64")
+}
+
+func TestWriteCycloneDXReferencesEveryFile(t *testing.T) {
+	builder := sbom.NewBuilder(sbom.PackageInfo{Name: "kubernetes-cni", Version: "1.2.3"}, testFiles())
+
+	var buf bytes.Buffer
+	require.NoError(t, builder.WriteCycloneDX(&buf))
+
+	var doc struct {
+		SpecVersion string `json:"specVersion"`
+		Components  []struct {
+			Name   string `json:"name"`
+			Hashes []struct {
+				Alg     string `json:"alg"`
+				Content string `json:"content"`
+			} `json:"hashes"`
+			Properties []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"properties"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	require.Equal(t, "1.5", doc.SpecVersion)
+	require.Len(t, doc.Components, 2)
+	require.Equal(t, "ef567890", doc.Components[1].Hashes[0].Content)
+
+	foundArch := false
+	for _, prop := range doc.Components[1].Properties {
+		if prop.Name == "architecture" && prop.Value == "arm64" {
+			foundArch = true
+		}
+	}
+	require.True(t, foundArch, "expected an architecture=arm64 property on the second component")
+}
+
+func TestWriteUnsupportedFormatErrors(t *testing.T) {
+	builder := sbom.NewBuilder(sbom.PackageInfo{Name: "kubernetes-cni", Version: "1.2.3"}, testFiles())
+
+	var buf bytes.Buffer
+	require.Error(t, builder.Write(sbom.Format("unknown"), &buf))
+}
@@ -0,0 +1,787 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package specs builds the upstream source archives that back the OBS
+// (Open Build Service) package definitions used to produce Kubernetes'
+// deb/rpm packages.
+package specs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"k8s.io/release/pkg/obs/specs/sbom"
+	"k8s.io/release/pkg/obs/specs/store"
+)
+
+// namesWithTarballDownload lists the packages whose upstream source is
+// fetched as a pre-built "<name>.tar.gz" artifact rather than a bare file.
+var namesWithTarballDownload = map[string]bool{
+	"kubernetes-cni": true,
+	"cri-tools":      true,
+	"cri-o":          true,
+}
+
+// archDirNames maps Go architecture identifiers to the directory names
+// used by the upstream release artifacts.
+var archDirNames = map[string]string{
+	"amd64": "x86_64",
+	"arm64": "aarch64",
+}
+
+// PackageDefinition describes a single OBS package spec: its name, the
+// version being packaged, where to stage the resulting archive, and the
+// per-architecture sources that make up the package.
+type PackageDefinition struct {
+	Name           string
+	Version        string
+	SpecOutputPath string
+	Variations     []PackageVariation
+}
+
+// PackageVariation describes one architecture-specific source artifact
+// that is downloaded and folded into the package's archive.
+type PackageVariation struct {
+	Architecture string
+
+	// Source lists, in preference order, the upstream mirrors this
+	// variation's artifact can be downloaded from. BuildArtifactsArchive
+	// tries each in turn, retrying transient failures on a mirror with
+	// backoff before falling through to the next one. The scheme before
+	// "://" of each entry selects the SourceFetcher used to retrieve it;
+	// out of the box that's "gs", "https"/"http", "file", and "oci" (see
+	// RegisterSourceFetcher for adding more).
+	Source []string
+
+	// SHA256 and SHA512 are the expected hex-encoded digests of the
+	// downloaded source. When set, the digest is verified before the
+	// source is extracted and the build fails on mismatch.
+	SHA256 string
+	SHA512 string
+
+	// Signature is a detached, armored PGP signature covering the
+	// downloaded source. When set it is verified against
+	// Options.PublicKeyringPath. cosign-style sidecar signatures are not
+	// supported. Signature must hold the signature's literal contents:
+	// there is no support for fetching a sidecar ".sig" file alongside
+	// Source, so packages whose signature isn't already inline have to
+	// be hand-embedded here.
+	Signature string
+
+	// PublicKey overrides Options.PublicKeyringPath for this variation,
+	// allowing a package to pin a different signer than the rest of the
+	// spec.
+	PublicKey string
+}
+
+// Options are the configurable knobs for Specs.
+type Options struct {
+	// PublicKeyringPath points at an armored PGP public keyring used to
+	// verify PackageVariation.Signature. It is loaded once and reused
+	// across all variations handled by a given Specs.
+	PublicKeyringPath string
+
+	// DownloadRetry configures the retry and rate-limiting behavior used
+	// when fetching PackageVariation.Source mirrors. The zero value is
+	// replaced with sensible defaults (see defaultDownloadRetryOptions).
+	DownloadRetry DownloadRetryOptions
+
+	// MaxConcurrentDownloads bounds how many variations are downloaded,
+	// verified, and extracted concurrently. Defaults to runtime.NumCPU(),
+	// capped at maxConcurrentDownloadsCap.
+	MaxConcurrentDownloads int
+
+	// CacheDir overrides the on-disk store's cache directory. Defaults
+	// to os.UserCacheDir()/k8s-obs-specs (see the store package).
+	CacheDir string
+
+	// EmitSBOM, when true, makes BuildArtifactsArchive synthesize an SBOM
+	// document enumerating every variation's downloaded source alongside
+	// the compressed archive.
+	EmitSBOM bool
+
+	// SBOMFormats selects which SBOM formats to emit when EmitSBOM is
+	// set. Defaults to both sbom.FormatSPDX and sbom.FormatCycloneDX.
+	SBOMFormats []sbom.Format
+}
+
+// maxConcurrentDownloadsCap is the hard ceiling on MaxConcurrentDownloads,
+// regardless of how many CPUs are available.
+const maxConcurrentDownloadsCap = 8
+
+// maxConcurrentDownloads resolves Options.MaxConcurrentDownloads to an
+// effective worker pool size.
+func (s *Specs) maxConcurrentDownloads() int {
+	limit := runtime.NumCPU()
+	if s.options != nil && s.options.MaxConcurrentDownloads > 0 {
+		limit = s.options.MaxConcurrentDownloads
+	}
+
+	if limit > maxConcurrentDownloadsCap {
+		limit = maxConcurrentDownloadsCap
+	}
+	if limit < 1 {
+		limit = 1
+	}
+
+	return limit
+}
+
+// DownloadRetryOptions bounds how hard BuildArtifactsArchive retries a
+// flaky mirror before giving up on it and moving to the next one.
+type DownloadRetryOptions struct {
+	// MaxAttempts is the number of times a single mirror is tried before
+	// moving on to the next one.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry of a mirror.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff delay between retries.
+	MaxBackoff time.Duration
+	// RateLimitQPS caps the overall rate of download attempts across all
+	// mirrors and variations in a single BuildArtifactsArchive call.
+	RateLimitQPS float64
+}
+
+const (
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 30 * time.Second
+	defaultRateLimitQPS   = 5.0
+)
+
+func defaultDownloadRetryOptions(opts DownloadRetryOptions) DownloadRetryOptions {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaultInitialBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultMaxBackoff
+	}
+	if opts.RateLimitQPS <= 0 {
+		opts.RateLimitQPS = defaultRateLimitQPS
+	}
+	return opts
+}
+
+// Specs builds source archives for OBS package definitions.
+type Specs struct {
+	impl    Impl
+	options *Options
+
+	keyringOnce sync.Once
+	keyring     string
+	keyringErr  error
+
+	limiterOnce sync.Once
+	limiter     *rate.Limiter
+}
+
+// New returns a new Specs using the provided Options.
+func New(options *Options) *Specs {
+	return &Specs{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// SetImpl allows injecting a different Impl, used by tests to fake out
+// the underlying filesystem, network, and compression calls.
+func (s *Specs) SetImpl(impl Impl) {
+	s.impl = impl
+}
+
+// BuildArtifactsArchive downloads the sources for each of pkgDef's
+// variations, extracts them into a staging subdirectory of
+// pkgDef.SpecOutputPath, and compresses that staging directory into a
+// "<name>_<version>.orig.tar.gz" archive under pkgDef.SpecOutputPath.
+// When Options.EmitSBOM is set, it also writes an SBOM document
+// describing the downloaded sources next to the archive.
+func (s *Specs) BuildArtifactsArchive(pkgDef *PackageDefinition) error {
+	if pkgDef == nil {
+		return errors.New("package definition cannot be nil")
+	}
+
+	stagingDir := filepath.Join(pkgDef.SpecOutputPath, stagingDirName)
+	if !s.impl.IsExist(stagingDir) {
+		if err := s.impl.MkdirAll(stagingDir, os.FileMode(0o755)); err != nil {
+			return fmt.Errorf("creating staging directory %s: %w", stagingDir, err)
+		}
+	}
+
+	g := new(errgroup.Group)
+	g.SetLimit(s.maxConcurrentDownloads())
+
+	manifest := make([]sbom.FileManifestEntry, len(pkgDef.Variations))
+
+	for i, variation := range pkgDef.Variations {
+		i, variation := i, variation
+		g.Go(func() error {
+			entry, err := s.processVariation(pkgDef, variation, stagingDir)
+			if err != nil {
+				return err
+			}
+
+			manifest[i] = entry
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	archiveFile := filepath.Join(pkgDef.SpecOutputPath, archiveFileName(pkgDef))
+	if err := s.impl.Compress(archiveFile, stagingDir, true); err != nil {
+		return fmt.Errorf("compressing artifacts for %s: %w", pkgDef.Name, err)
+	}
+
+	if err := s.emitSBOMs(pkgDef, manifest); err != nil {
+		return fmt.Errorf("emitting SBOM for %s: %w", pkgDef.Name, err)
+	}
+
+	if err := s.impl.RemoveAll(stagingDir); err != nil {
+		return fmt.Errorf("cleaning up staging directory %s: %w", stagingDir, err)
+	}
+
+	return nil
+}
+
+// processVariation downloads, verifies, and extracts a single variation
+// into stagingDir, returning a manifest entry describing the file it
+// downloaded. It is safe to run concurrently for different variations
+// of the same pkgDef: each downloads to its own destinationPath, and
+// Impl implementations (including FakeImpl) are expected to be safe for
+// concurrent use.
+func (s *Specs) processVariation(pkgDef *PackageDefinition, variation PackageVariation, stagingDir string) (sbom.FileManifestEntry, error) {
+	destinationPath := s.destinationPathFor(pkgDef, variation)
+
+	destinationDir := filepath.Dir(destinationPath)
+	if !s.impl.IsExist(destinationDir) {
+		if err := s.impl.MkdirAll(destinationDir, os.FileMode(0o755)); err != nil {
+			return sbom.FileManifestEntry{}, fmt.Errorf("creating destination directory %s: %w", destinationDir, err)
+		}
+	}
+
+	resolvedSource, err := s.fetchVariation(pkgDef, variation, destinationPath)
+	if err != nil {
+		return sbom.FileManifestEntry{}, fmt.Errorf("fetching source for %s: %w", pkgDef.Name, err)
+	}
+
+	entry, err := s.manifestEntryFor(variation, destinationPath, resolvedSource)
+	if err != nil {
+		return sbom.FileManifestEntry{}, fmt.Errorf("recording manifest entry for %s: %w", destinationPath, err)
+	}
+
+	if err := s.impl.Extract(destinationPath, stagingDir); err != nil {
+		return sbom.FileManifestEntry{}, fmt.Errorf("extracting %s: %w", destinationPath, err)
+	}
+
+	if err := s.impl.RemoveFile(destinationPath); err != nil {
+		return sbom.FileManifestEntry{}, fmt.Errorf("removing downloaded archive %s: %w", destinationPath, err)
+	}
+
+	return entry, nil
+}
+
+// manifestEntryFor describes the file at path (variation's downloaded
+// source) for inclusion in the package's SBOM. resolvedSource is the
+// mirror that actually supplied the bytes at path, which may differ from
+// variation.Source's first-preference entry when earlier mirrors failed.
+func (s *Specs) manifestEntryFor(variation PackageVariation, path, resolvedSource string) (sbom.FileManifestEntry, error) {
+	size, err := s.impl.FileSize(path)
+	if err != nil {
+		return sbom.FileManifestEntry{}, fmt.Errorf("statting %s: %w", path, err)
+	}
+
+	sum, err := s.impl.SHA256ForFile(path)
+	if err != nil {
+		return sbom.FileManifestEntry{}, fmt.Errorf("hashing %s: %w", path, err)
+	}
+
+	// destinationPathFor joins its components with `\`, so the file's own
+	// name is whatever follows the last one.
+	name := filepath.Base(path)
+	if i := strings.LastIndex(name, `\`); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return sbom.FileManifestEntry{
+		Name:         name,
+		Size:         size,
+		SHA256:       sum,
+		Architecture: variation.Architecture,
+		Source:       resolvedSource,
+	}, nil
+}
+
+// emitSBOMs writes an SBOM document describing manifest for each format
+// in Options.SBOMFormats next to pkgDef's archive, or does nothing
+// unless Options.EmitSBOM is set.
+func (s *Specs) emitSBOMs(pkgDef *PackageDefinition, manifest []sbom.FileManifestEntry) error {
+	if s.options == nil || !s.options.EmitSBOM {
+		return nil
+	}
+
+	formats := s.options.SBOMFormats
+	if len(formats) == 0 {
+		formats = []sbom.Format{sbom.FormatSPDX, sbom.FormatCycloneDX}
+	}
+
+	builder := sbom.NewBuilder(sbom.PackageInfo{Name: pkgDef.Name, Version: pkgDef.Version}, manifest)
+
+	for _, format := range formats {
+		path := filepath.Join(pkgDef.SpecOutputPath, sbomFileName(pkgDef, format))
+
+		out, err := s.impl.CreateFile(path)
+		if err != nil {
+			return fmt.Errorf("creating SBOM file %s: %w", path, err)
+		}
+
+		writeErr := builder.Write(format, out)
+		closeErr := out.Close()
+
+		if writeErr != nil {
+			return fmt.Errorf("writing SBOM file %s: %w", path, writeErr)
+		}
+		if closeErr != nil {
+			return fmt.Errorf("closing SBOM file %s: %w", path, closeErr)
+		}
+	}
+
+	return nil
+}
+
+// sbomFileName returns the name pkgDef's SBOM document in format should
+// be given on disk.
+func sbomFileName(pkgDef *PackageDefinition, format sbom.Format) string {
+	switch format {
+	case sbom.FormatCycloneDX:
+		return fmt.Sprintf("%s_%s.orig.cdx.json", pkgDef.Name, pkgDef.Version)
+	default:
+		return fmt.Sprintf("%s_%s.orig.spdx.json", pkgDef.Name, pkgDef.Version)
+	}
+}
+
+// fetchVariation populates destinationPath with variation's verified
+// source, serving it out of the on-disk store when a previous run
+// already downloaded and verified the same source and digest. Either
+// way, the copy at destinationPath is re-verified against variation's
+// digest/signature before fetchVariation returns, so a tampered or
+// corrupted cache entry is caught rather than trusted. On a miss, it
+// downloads as usual, then caches the result for next time. It returns
+// the resolved source URL the bytes actually came from, for the SBOM's
+// provenance record: on a cache hit that's the primary (first-preference)
+// mirror the cache key is derived from, since StoreLookup returns only the
+// cached file's path and not the mirror recorded with it; on a miss it's
+// whichever mirror downloadSource actually fetched from.
+func (s *Specs) fetchVariation(pkgDef *PackageDefinition, variation PackageVariation, destinationPath string) (string, error) {
+	cacheKey := cacheKeyFor(variation)
+
+	cachedPath, hit, err := s.impl.StoreLookup(s.cacheDir(), cacheKey)
+	if err != nil {
+		return "", fmt.Errorf("checking cache: %w", err)
+	}
+	if hit {
+		if err := s.impl.CopyLocalFile(cachedPath, destinationPath); err != nil {
+			return "", err
+		}
+
+		if err := s.verifyIntegrity(variation, destinationPath); err != nil {
+			return "", err
+		}
+
+		return primarySource(variation), nil
+	}
+
+	resolvedSource, err := s.downloadSource(variation, destinationPath)
+	if err != nil {
+		return "", fmt.Errorf("downloading source: %w", err)
+	}
+
+	if err := s.verifyIntegrity(variation, destinationPath); err != nil {
+		return "", fmt.Errorf("verifying integrity of %s: %w", destinationPath, err)
+	}
+
+	if _, err := s.impl.StoreInsert(s.cacheDir(), cacheKey, destinationPath, resolvedSource, primaryDigest(variation), pkgDef.Version); err != nil {
+		return "", fmt.Errorf("caching downloaded source: %w", err)
+	}
+
+	return resolvedSource, nil
+}
+
+// cacheDir returns the configured Options.CacheDir, or the empty string
+// (the store package's default) if none is set.
+func (s *Specs) cacheDir() string {
+	if s.options == nil {
+		return ""
+	}
+	return s.options.CacheDir
+}
+
+// cacheKeyFor returns the store key for variation, derived from its
+// primary (first-preference) source mirror and declared digest. Keying
+// on the primary mirror lets a cache hit skip the mirror list and the
+// network entirely, rather than only saving work after a mirror is
+// chosen.
+func cacheKeyFor(variation PackageVariation) string {
+	return store.Key(primarySource(variation), primaryDigest(variation))
+}
+
+func primarySource(variation PackageVariation) string {
+	if len(variation.Source) == 0 {
+		return ""
+	}
+	return variation.Source[0]
+}
+
+func primaryDigest(variation PackageVariation) string {
+	if variation.SHA256 != "" {
+		return variation.SHA256
+	}
+	return variation.SHA512
+}
+
+// SourceFetcher retrieves a single mirror of variation's source into
+// destination on local disk, using impl for any filesystem, network, or
+// registry access so that fetchers stay testable through FakeImpl.
+type SourceFetcher func(impl Impl, source string, variation PackageVariation, destination string) error
+
+var (
+	sourceFetchersMutex sync.RWMutex
+	sourceFetchers      = map[string]SourceFetcher{
+		"gs":    fetchGCSSource,
+		"https": fetchHTTPSource,
+		"http":  fetchHTTPSource,
+		"file":  fetchFileSource,
+		"oci":   fetchOCISource,
+	}
+)
+
+// RegisterSourceFetcher registers fetcher as the SourceFetcher used for
+// PackageVariation.Source mirrors with the given scheme (the part before
+// "://"), replacing any existing fetcher for that scheme.
+func RegisterSourceFetcher(scheme string, fetcher SourceFetcher) {
+	sourceFetchersMutex.Lock()
+	defer sourceFetchersMutex.Unlock()
+	sourceFetchers[scheme] = fetcher
+}
+
+// downloadSource tries each of variation.Source's mirrors in turn,
+// retrying transient failures on a mirror with bounded exponential
+// backoff before falling through to the next mirror. It returns the
+// mirror that actually served the artifact, which may not be the first
+// (preferred) one if earlier mirrors failed.
+func (s *Specs) downloadSource(variation PackageVariation, destination string) (string, error) {
+	if len(variation.Source) == 0 {
+		return "", errors.New("variation has no source mirrors")
+	}
+
+	retryOpts := defaultDownloadRetryOptions(s.downloadRetryOptions())
+
+	var lastErr error
+	for _, source := range variation.Source {
+		lastErr = s.downloadMirrorWithRetry(source, variation, destination, retryOpts)
+		if lastErr == nil {
+			return source, nil
+		}
+	}
+
+	return "", fmt.Errorf("all mirrors exhausted: %w", lastErr)
+}
+
+// downloadRetryOptions returns the DownloadRetry configured on Options,
+// or the zero value if none is set.
+func (s *Specs) downloadRetryOptions() DownloadRetryOptions {
+	if s.options == nil {
+		return DownloadRetryOptions{}
+	}
+	return s.options.DownloadRetry
+}
+
+// rateLimiter returns the global download rate limiter for this Specs,
+// creating it from retryOpts on first use.
+func (s *Specs) rateLimiter(retryOpts DownloadRetryOptions) *rate.Limiter {
+	s.limiterOnce.Do(func() {
+		s.limiter = rate.NewLimiter(rate.Limit(retryOpts.RateLimitQPS), 1)
+	})
+	return s.limiter
+}
+
+// downloadMirrorWithRetry fetches source, retrying HTTP 429/5xx and
+// transient network errors with exponential backoff. A 404, or any other
+// non-retryable error, returns immediately so the caller can move on to
+// the next mirror.
+func (s *Specs) downloadMirrorWithRetry(source string, variation PackageVariation, destination string, retryOpts DownloadRetryOptions) error {
+	limiter := s.rateLimiter(retryOpts)
+
+	backoff := wait.Backoff{
+		Duration: retryOpts.InitialBackoff,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    retryOpts.MaxAttempts,
+		Cap:      retryOpts.MaxBackoff,
+	}
+
+	var lastErr error
+	for backoff.Steps > 0 {
+		if err := limiter.Wait(context.Background()); err != nil {
+			return fmt.Errorf("waiting for download rate limiter: %w", err)
+		}
+
+		err := s.fetchMirror(source, variation, destination)
+		if err == nil {
+			return nil
+		}
+
+		lastErr = err
+
+		if !isRetryableError(err) {
+			return err
+		}
+
+		time.Sleep(backoff.Step())
+	}
+
+	return lastErr
+}
+
+// fetchMirror dispatches a single attempt at source to the SourceFetcher
+// registered for its scheme.
+func (s *Specs) fetchMirror(source string, variation PackageVariation, destination string) error {
+	scheme := sourceScheme(source)
+
+	sourceFetchersMutex.RLock()
+	fetcher, ok := sourceFetchers[scheme]
+	sourceFetchersMutex.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("unsupported source scheme: %s", source)
+	}
+
+	return fetcher(s.impl, source, variation, destination)
+}
+
+// sourceScheme returns the scheme portion of source (the part before
+// "://"), or the empty string if source has none.
+func sourceScheme(source string) string {
+	if i := strings.Index(source, "://"); i >= 0 {
+		return source[:i]
+	}
+
+	return ""
+}
+
+// httpStatusError is returned by fetchHTTPSource for a non-200 response
+// so that isRetryableError can tell a 404 (move to the next mirror)
+// apart from a 429/5xx (retry this one).
+type httpStatusError struct {
+	StatusCode int
+	URL        string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("unexpected status code %d downloading %s", e.StatusCode, e.URL)
+}
+
+// isRetryableError reports whether err represents a transient failure
+// worth retrying against the same mirror: an HTTP 429/5xx, or a network
+// error that reports a timeout. A 404 and everything else is not
+// retryable, so the caller falls through to the next mirror immediately.
+func isRetryableError(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout()
+	}
+
+	return false
+}
+
+// fetchGCSSource fetches a "gs://" source.
+func fetchGCSSource(impl Impl, source string, variation PackageVariation, destination string) error {
+	return impl.GCSCopyToLocal(source, destination)
+}
+
+// fetchHTTPSource fetches an "http://" or "https://" source.
+func fetchHTTPSource(impl Impl, source string, variation PackageVariation, destination string) error {
+	out, err := impl.CreateFile(destination)
+	if err != nil {
+		return fmt.Errorf("creating destination file %s: %w", destination, err)
+	}
+	defer out.Close()
+
+	resp, err := impl.GetRequest(source)
+	if err != nil {
+		return fmt.Errorf("requesting %s: %w", source, err)
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return &httpStatusError{StatusCode: resp.StatusCode, URL: source}
+	}
+
+	if resp.Body != nil {
+		if _, err := io.Copy(out, resp.Body); err != nil {
+			return fmt.Errorf("writing downloaded content for %s: %w", source, err)
+		}
+	}
+
+	return nil
+}
+
+// fetchFileSource copies a "file://" source from local disk.
+func fetchFileSource(impl Impl, source string, variation PackageVariation, destination string) error {
+	localPath := strings.TrimPrefix(source, "file://")
+
+	if err := impl.CopyLocalFile(localPath, destination); err != nil {
+		return fmt.Errorf("copying %s: %w", localPath, err)
+	}
+
+	return nil
+}
+
+// fetchOCISource pulls a layer out of an "oci://registry/repo:tag" image,
+// selecting the manifest matching variation.Architecture when the
+// reference resolves to a multi-arch image index.
+func fetchOCISource(impl Impl, source string, variation PackageVariation, destination string) error {
+	ref := strings.TrimPrefix(source, "oci://")
+
+	if err := impl.FetchOCIArtifact(ref, variation.Architecture, destination); err != nil {
+		return fmt.Errorf("fetching %s: %w", ref, err)
+	}
+
+	return nil
+}
+
+// destinationPathFor returns the path under pkgDef.SpecOutputPath that
+// variation's source should be downloaded to.
+func (s *Specs) destinationPathFor(pkgDef *PackageDefinition, variation PackageVariation) string {
+	parts := []string{pkgDef.Name}
+
+	if archDir, ok := archDirNames[variation.Architecture]; ok {
+		parts = append(parts, archDir)
+	} else if variation.Architecture != "" {
+		parts = append(parts, variation.Architecture)
+	}
+
+	parts = append(parts, downloadFileName(pkgDef))
+
+	return filepath.Join(pkgDef.SpecOutputPath, strings.Join(parts, `\`))
+}
+
+// downloadFileName returns the name the downloaded artifact should be
+// given on disk.
+func downloadFileName(pkgDef *PackageDefinition) string {
+	if namesWithTarballDownload[pkgDef.Name] {
+		return pkgDef.Name + ".tar.gz"
+	}
+
+	return pkgDef.Name
+}
+
+// archiveFileName returns the name of the final compressed archive for
+// pkgDef.
+func archiveFileName(pkgDef *PackageDefinition) string {
+	return fmt.Sprintf("%s_%s.orig.tar.gz", pkgDef.Name, pkgDef.Version)
+}
+
+// stagingDirName is the name of the scratch subdirectory of
+// PackageDefinition.SpecOutputPath that variations are extracted into
+// before being compressed into the final archive. It is removed once
+// BuildArtifactsArchive finishes with it, leaving only the archive (and,
+// when requested, SBOM documents) behind in SpecOutputPath.
+const stagingDirName = ".staging"
+
+// verifyIntegrity checks the downloaded source at path against whatever
+// digests and/or signature variation declares, failing closed: a
+// declared digest or signature that cannot be verified is an error, but
+// variations that declare neither are left unchecked.
+func (s *Specs) verifyIntegrity(variation PackageVariation, path string) error {
+	if variation.SHA256 != "" {
+		sum, err := s.impl.SHA256ForFile(path)
+		if err != nil {
+			return fmt.Errorf("computing sha256: %w", err)
+		}
+		if !strings.EqualFold(sum, variation.SHA256) {
+			return fmt.Errorf("sha256 mismatch: want %s, got %s", variation.SHA256, sum)
+		}
+	}
+
+	if variation.SHA512 != "" {
+		sum, err := s.impl.SHA512ForFile(path)
+		if err != nil {
+			return fmt.Errorf("computing sha512: %w", err)
+		}
+		if !strings.EqualFold(sum, variation.SHA512) {
+			return fmt.Errorf("sha512 mismatch: want %s, got %s", variation.SHA512, sum)
+		}
+	}
+
+	if variation.Signature != "" {
+		keyring, err := s.publicKeyring(variation)
+		if err != nil {
+			return fmt.Errorf("loading public keyring: %w", err)
+		}
+
+		if err := s.impl.VerifySignature(path, variation.Signature, keyring); err != nil {
+			return fmt.Errorf("verifying signature: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// publicKeyring returns the armored public keyring used to verify
+// variation's signature, preferring a variation-specific key over
+// Options.PublicKeyringPath. The path configured on Options is only read
+// from disk once per Specs, regardless of how many variations share it.
+func (s *Specs) publicKeyring(variation PackageVariation) (string, error) {
+	if variation.PublicKey != "" {
+		return s.impl.LoadPublicKeyring(variation.PublicKey)
+	}
+
+	s.keyringOnce.Do(func() {
+		if s.options == nil || s.options.PublicKeyringPath == "" {
+			return
+		}
+		s.keyring, s.keyringErr = s.impl.LoadPublicKeyring(s.options.PublicKeyringPath)
+	})
+
+	return s.keyring, s.keyringErr
+}
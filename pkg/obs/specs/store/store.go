@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package store implements a content-addressable, on-disk cache of
+// previously downloaded and verified OBS package source artifacts, so
+// that rebuilding the same package spec twice doesn't re-fetch its
+// sources from the network.
+package store
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// defaultDirName is the subdirectory of os.UserCacheDir() the store lives
+// in by default.
+const defaultDirName = "k8s-obs-specs"
+
+// Store is an on-disk, content-addressable cache of downloaded source
+// artifacts. A Store is safe for concurrent use: every operation is a
+// self-contained filesystem call, and inserts land via an atomic rename.
+type Store struct {
+	dir string
+}
+
+// Metadata records what a cached artifact is, so List, Prune, and
+// RemoveVersion can operate on human-meaningful terms instead of raw
+// cache keys.
+type Metadata struct {
+	Key       string
+	Source    string
+	Digest    string
+	Version   string
+	CreatedAt time.Time
+}
+
+// New opens the cache directory at dir, creating it if necessary. An
+// empty dir defaults to os.UserCacheDir()/k8s-obs-specs.
+func New(dir string) (*Store, error) {
+	if dir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, fmt.Errorf("resolving user cache dir: %w", err)
+		}
+		dir = filepath.Join(userCacheDir, defaultDirName)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating cache dir %s: %w", dir, err)
+	}
+
+	return &Store{dir: dir}, nil
+}
+
+// Key returns the content-addressable cache key for a source location
+// and its declared digest (empty if the variation declares none).
+func Key(source, digest string) string {
+	sum := sha256.Sum256([]byte(source + "|" + digest))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *Store) blobPath(key string) string {
+	return filepath.Join(s.dir, key)
+}
+
+func (s *Store) metaPath(key string) string {
+	return filepath.Join(s.dir, key+".json")
+}
+
+// Lookup returns the local path of the cached artifact for key, and
+// whether one was found.
+func (s *Store) Lookup(key string) (string, bool, error) {
+	path := s.blobPath(key)
+
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("checking cache for %s: %w", key, err)
+	}
+
+	return path, true, nil
+}
+
+// Insert atomically moves tempFile into the store under key and records
+// meta alongside it, returning the artifact's final on-disk path.
+// tempFile must already be on the same filesystem as the store (the
+// caller typically creates it with os.CreateTemp(dir, ...) using the
+// directory returned by Dir).
+func (s *Store) Insert(key, tempFile string, meta Metadata) (string, error) {
+	meta.Key = key
+	meta.CreatedAt = meta.CreatedAt.UTC()
+
+	dest := s.blobPath(key)
+	if err := os.Rename(tempFile, dest); err != nil {
+		return "", fmt.Errorf("moving %s into store: %w", tempFile, err)
+	}
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return "", fmt.Errorf("encoding cache metadata: %w", err)
+	}
+
+	if err := os.WriteFile(s.metaPath(key), data, 0o644); err != nil {
+		return "", fmt.Errorf("writing cache metadata: %w", err)
+	}
+
+	return dest, nil
+}
+
+// Dir returns the store's cache directory, so callers can stage a
+// temporary file on the same filesystem before calling Insert.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// List returns metadata for every artifact currently in the store.
+func (s *Store) List() ([]Metadata, error) {
+	matches, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("listing cache metadata: %w", err)
+	}
+
+	entries := make([]Metadata, 0, len(matches))
+	for _, match := range matches {
+		meta, err := readMetadata(match)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, meta)
+	}
+
+	return entries, nil
+}
+
+// Prune removes every cached artifact older than olderThan, returning
+// the keys it removed.
+func (s *Store) Prune(olderThan time.Duration) ([]string, error) {
+	entries, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+
+	var removed []string
+	for _, entry := range entries {
+		if entry.CreatedAt.After(cutoff) {
+			continue
+		}
+		if err := s.removeKey(entry.Key); err != nil {
+			return removed, err
+		}
+		removed = append(removed, entry.Key)
+	}
+
+	return removed, nil
+}
+
+// RemoveVersion removes the cached artifact recorded under version,
+// returning an error if none is found.
+func (s *Store) RemoveVersion(version string) error {
+	entries, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Version == version {
+			return s.removeKey(entry.Key)
+		}
+	}
+
+	return fmt.Errorf("no cached artifact for version %s", version)
+}
+
+func (s *Store) removeKey(key string) error {
+	if err := os.Remove(s.blobPath(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing cached artifact %s: %w", key, err)
+	}
+
+	if err := os.Remove(s.metaPath(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing cache metadata %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func readMetadata(path string) (Metadata, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("reading cache metadata %s: %w", path, err)
+	}
+
+	var meta Metadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return Metadata{}, fmt.Errorf("decoding cache metadata %s: %w", path, err)
+	}
+
+	return meta, nil
+}
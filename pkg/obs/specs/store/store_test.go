@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/obs/specs/store"
+)
+
+func TestKeyIsStableAndDigestSensitive(t *testing.T) {
+	a := store.Key("https://example.com/foo.tar.gz", "deadbeef")
+	b := store.Key("https://example.com/foo.tar.gz", "deadbeef")
+	c := store.Key("https://example.com/foo.tar.gz", "otherdigest")
+
+	require.Equal(t, a, b)
+	require.NotEqual(t, a, c)
+}
+
+func TestLookupMissesOnEmptyStore(t *testing.T) {
+	s, err := store.New(t.TempDir())
+	require.NoError(t, err)
+
+	_, hit, err := s.Lookup(store.Key("https://example.com/foo.tar.gz", "deadbeef"))
+	require.NoError(t, err)
+	require.False(t, hit)
+}
+
+func TestInsertThenLookupHits(t *testing.T) {
+	dir := t.TempDir()
+	s, err := store.New(dir)
+	require.NoError(t, err)
+
+	key := store.Key("https://example.com/foo.tar.gz", "deadbeef")
+
+	tempFile, err := os.CreateTemp(s.Dir(), "artifact-*")
+	require.NoError(t, err)
+	_, err = tempFile.WriteString("archive contents")
+	require.NoError(t, err)
+	require.NoError(t, tempFile.Close())
+
+	insertedPath, err := s.Insert(key, tempFile.Name(), store.Metadata{
+		Source:    "https://example.com/foo.tar.gz",
+		Digest:    "deadbeef",
+		Version:   "1.2.3",
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	path, hit, err := s.Lookup(key)
+	require.NoError(t, err)
+	require.True(t, hit)
+	require.Equal(t, insertedPath, path)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "archive contents", string(data))
+}
+
+func TestListReturnsEveryCachedEntry(t *testing.T) {
+	s, err := store.New(t.TempDir())
+	require.NoError(t, err)
+
+	insertFixture(t, s, "https://example.com/a.tar.gz", "1.0.0", time.Now())
+	insertFixture(t, s, "https://example.com/b.tar.gz", "2.0.0", time.Now())
+
+	entries, err := s.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestPruneRemovesOnlyStaleEntries(t *testing.T) {
+	s, err := store.New(t.TempDir())
+	require.NoError(t, err)
+
+	staleKey := insertFixture(t, s, "https://example.com/a.tar.gz", "1.0.0", time.Now().Add(-48*time.Hour))
+	freshKey := insertFixture(t, s, "https://example.com/b.tar.gz", "2.0.0", time.Now())
+
+	removed, err := s.Prune(24 * time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, []string{staleKey}, removed)
+
+	_, hit, err := s.Lookup(staleKey)
+	require.NoError(t, err)
+	require.False(t, hit)
+
+	_, hit, err = s.Lookup(freshKey)
+	require.NoError(t, err)
+	require.True(t, hit)
+}
+
+func TestRemoveVersionRemovesTheMatchingEntry(t *testing.T) {
+	s, err := store.New(t.TempDir())
+	require.NoError(t, err)
+
+	key := insertFixture(t, s, "https://example.com/a.tar.gz", "1.0.0", time.Now())
+
+	require.NoError(t, s.RemoveVersion("1.0.0"))
+
+	_, hit, err := s.Lookup(key)
+	require.NoError(t, err)
+	require.False(t, hit)
+}
+
+func TestRemoveVersionErrorsWhenNotFound(t *testing.T) {
+	s, err := store.New(t.TempDir())
+	require.NoError(t, err)
+
+	require.Error(t, s.RemoveVersion("9.9.9"))
+}
+
+func TestNewDefaultsToUserCacheDir(t *testing.T) {
+	userCacheDir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", userCacheDir)
+
+	s, err := store.New("")
+	require.NoError(t, err)
+
+	require.DirExists(t, filepath.Join(userCacheDir, "k8s-obs-specs"))
+	require.Equal(t, filepath.Join(userCacheDir, "k8s-obs-specs"), s.Dir())
+}
+
+func insertFixture(t *testing.T, s *store.Store, source, version string, createdAt time.Time) string {
+	t.Helper()
+
+	key := store.Key(source, version)
+
+	tempFile, err := os.CreateTemp(s.Dir(), "artifact-*")
+	require.NoError(t, err)
+	require.NoError(t, tempFile.Close())
+
+	_, err = s.Insert(key, tempFile.Name(), store.Metadata{
+		Source:    source,
+		Digest:    version,
+		Version:   version,
+		CreatedAt: createdAt,
+	})
+	require.NoError(t, err)
+
+	return key
+}